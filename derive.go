@@ -23,11 +23,29 @@ var (
 	reJunction = regexp.MustCompile(`/(/?[^/]+)`)
 )
 
+// DeriveJunction is a single hard or soft junction of the derivation path.
 type DeriveJunction struct {
 	ChainCode [32]byte
 	IsHard    bool
 }
 
+// ParseURI parses the secret URI into the phrase, derivation junctions and password.
+// URI is of the form `<phrase or hex seed>//hard/soft///password`.
+// DevPhrase is returned when the URI has no phrase.
+func ParseURI(uri string) (phrase string, path []DeriveJunction, password string, err error) {
+	phrase, p, password, err := splitURI(uri)
+	if err != nil {
+		return "", nil, "", err
+	}
+
+	path, err = deriveJunctions(derivePath(p))
+	if err != nil {
+		return "", nil, "", err
+	}
+
+	return phrase, path, password, nil
+}
+
 func deriveJunctions(codes []string) (djs []DeriveJunction, err error) {
 	for _, code := range codes {
 		dj, err := parseDeriveJunction(code)
@@ -104,3 +104,23 @@ func TestSplitURI(t *testing.T) {
 		})
 	}
 }
+
+func TestParseURI(t *testing.T) {
+	phrase, path, password, err := ParseURI(DevPhrase + "//foo/bar//42///password")
+	assert.NoError(t, err)
+	assert.Equal(t, DevPhrase, phrase)
+	assert.Equal(t, "password", password)
+	assert.Len(t, path, 3)
+
+	foo := [32]byte{0x0c, 'f', 'o', 'o'}
+	assert.Equal(t, DeriveJunction{ChainCode: foo, IsHard: true}, path[0])
+	bar := [32]byte{0x0c, 'b', 'a', 'r'}
+	assert.Equal(t, DeriveJunction{ChainCode: bar, IsHard: false}, path[1])
+	assert.Equal(t, DeriveJunction{ChainCode: [32]byte{42}, IsHard: true}, path[2])
+
+	phrase, path, password, err = ParseURI("0x18446f2d685492c3086391aabe8f5e235c3c2e02521985650f0c97052237e717")
+	assert.NoError(t, err)
+	assert.Equal(t, "0x18446f2d685492c3086391aabe8f5e235c3c2e02521985650f0c97052237e717", phrase)
+	assert.Empty(t, path)
+	assert.Empty(t, password)
+}
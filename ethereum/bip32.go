@@ -0,0 +1,102 @@
+package ethereum
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"strconv"
+	"strings"
+
+	secp256k1 "github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	hardenedOffset = 0x80000000
+
+	keyLength = 32
+)
+
+var masterSecret = []byte("Bitcoin seed")
+
+// parsePath parses the BIP32 path into child indexes.
+// Hardened indexes are suffixed with `'`.
+func parsePath(path string) ([]uint32, error) {
+	parts := strings.Split(path, "/")
+	if parts[0] != "m" {
+		return nil, errors.New("invalid derivation path")
+	}
+
+	var indexes []uint32
+	for _, p := range parts[1:] {
+		var offset uint32
+		if strings.HasSuffix(p, "'") {
+			offset = hardenedOffset
+			p = strings.TrimSuffix(p, "'")
+		}
+
+		i, err := strconv.ParseUint(p, 10, 31)
+		if err != nil {
+			return nil, errors.New("invalid derivation path")
+		}
+
+		indexes = append(indexes, uint32(i)+offset)
+	}
+
+	return indexes, nil
+}
+
+// deriveKey derives the private key from the BIP39 seed along the indexes.
+// More here: https://github.com/bitcoin/bips/blob/master/bip-0032.mediawiki
+func deriveKey(seed []byte, indexes []uint32) ([]byte, error) {
+	mac := hmac.New(sha512.New, masterSecret)
+	mac.Write(seed)
+	i := mac.Sum(nil)
+	key, cc := i[:keyLength], i[keyLength:]
+	var err error
+	for _, index := range indexes {
+		key, cc, err = deriveChild(key, cc, index)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return key, nil
+}
+
+func deriveChild(key, cc []byte, index uint32) ([]byte, []byte, error) {
+	var data []byte
+	if index >= hardenedOffset {
+		data = append([]byte{0}, key...)
+	} else {
+		secret, err := secp256k1.ToECDSA(key)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		data = secp256k1.CompressPubkey(&secret.PublicKey)
+	}
+
+	ib := make([]byte, 4)
+	binary.BigEndian.PutUint32(ib, index)
+	data = append(data, ib...)
+
+	mac := hmac.New(sha512.New, cc)
+	mac.Write(data)
+	i := mac.Sum(nil)
+
+	n := secp256k1.S256().Params().N
+	il := new(big.Int).SetBytes(i[:keyLength])
+	if il.Cmp(n) >= 0 {
+		return nil, nil, errors.New("invalid child key")
+	}
+
+	k := il.Add(il, new(big.Int).SetBytes(key))
+	k.Mod(k, n)
+	if k.Sign() == 0 {
+		return nil, nil, errors.New("invalid child key")
+	}
+
+	return k.FillBytes(make([]byte, keyLength)), i[keyLength:], nil
+}
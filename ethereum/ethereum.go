@@ -0,0 +1,143 @@
+package ethereum
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/go-bip39"
+	"github.com/ethereum/go-ethereum/common"
+	secp256k1 "github.com/ethereum/go-ethereum/crypto"
+	"github.com/vedhavyas/go-subkey"
+)
+
+const (
+	signatureLength = 65
+
+	addressLength = common.AddressLength
+)
+
+// BIP44Path returns the standard Ethereum BIP44 derivation path for the account index.
+func BIP44Path(index uint32) string {
+	return fmt.Sprintf("m/44'/60'/0'/0/%d", index)
+}
+
+// Address returns the EIP-55 checksummed H160 address of the signer.
+// The signer must use an Ethereum style AccountID20.
+func Address(signer subkey.Signer) (string, error) {
+	accountID := signer.AccountID()
+	if len(accountID) != addressLength {
+		return "", errors.New("invalid account id length")
+	}
+
+	return common.BytesToAddress(accountID).Hex(), nil
+}
+
+type keyRing struct {
+	secret *ecdsa.PrivateKey
+	pub    *ecdsa.PublicKey
+}
+
+// Sign signs the keccak256 hash of the message and returns the
+// 65 byte [R || S || V] signature used by Frontier based chains.
+func (kr keyRing) Sign(msg []byte) (signature []byte, err error) {
+	digest := secp256k1.Keccak256(msg)
+	return secp256k1.Sign(digest, kr.secret)
+}
+
+func (kr keyRing) Verify(msg []byte, signature []byte) bool {
+	if len(signature) != signatureLength {
+		return false
+	}
+
+	digest := secp256k1.Keccak256(msg)
+	return secp256k1.VerifySignature(kr.Public(), digest, signature[:64])
+}
+
+func (kr keyRing) Seed() []byte {
+	return secp256k1.FromECDSA(kr.secret)
+}
+
+func (kr keyRing) Public() []byte {
+	return secp256k1.CompressPubkey(kr.pub)
+}
+
+// AccountID returns the H160 address derived from the keccak256 hash of the public key.
+func (kr keyRing) AccountID() []byte {
+	return secp256k1.PubkeyToAddress(*kr.pub).Bytes()
+}
+
+func (kr keyRing) SS58Address(network uint8) (string, error) {
+	return subkey.SS58Address(kr.AccountID(), network)
+}
+
+func (kr keyRing) SS58AddressWithAccountIDChecksum(network uint8) (string, error) {
+	return subkey.SS58AddressWithAccountIDChecksum(kr.AccountID(), network)
+}
+
+// Scheme is the secp256k1 scheme used by Ethereum compatible chains with AccountID20.
+// Keys from a mnemonic are derived using BIP32 along the BIP44 path.
+type Scheme struct{}
+
+func (s Scheme) String() string {
+	return "Ethereum"
+}
+
+func (s Scheme) Generate() (subkey.KeyPair, error) {
+	secret, err := secp256k1.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return keyRing{
+		secret: secret,
+		pub:    secret.Public().(*ecdsa.PublicKey),
+	}, nil
+}
+
+func (s Scheme) FromSeed(seed []byte) (subkey.KeyPair, error) {
+	secret, err := secp256k1.ToECDSA(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	return keyRing{
+		secret: secret,
+		pub:    secret.Public().(*ecdsa.PublicKey),
+	}, nil
+}
+
+// FromPhrase derives the key of the first account, BIP44Path(0), from the mnemonic.
+func (s Scheme) FromPhrase(phrase, pwd string) (subkey.KeyPair, error) {
+	return s.FromPhraseWithPath(phrase, pwd, BIP44Path(0))
+}
+
+// FromPhraseWithPath derives the key from the mnemonic along the BIP32 path.
+// Path is of the form m/44'/60'/0'/0/0.
+func (s Scheme) FromPhraseWithPath(phrase, pwd, path string) (subkey.KeyPair, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(phrase, pwd)
+	if err != nil {
+		return nil, err
+	}
+
+	indexes, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := deriveKey(seed, indexes)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.FromSeed(key)
+}
+
+// Derive does not support substrate junctions. Use FromPhraseWithPath instead.
+func (s Scheme) Derive(pair subkey.KeyPair, djs []subkey.DeriveJunction) (subkey.KeyPair, error) {
+	if len(djs) > 0 {
+		return nil, errors.New("junction derivation is not supported")
+	}
+
+	return pair, nil
+}
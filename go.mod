@@ -5,7 +5,7 @@ go 1.17
 require (
 	github.com/ChainSafe/go-schnorrkel v1.0.0
 	github.com/btcsuite/btcd v0.22.0-beta // indirect
-	github.com/cosmos/go-bip39 v1.0.0 // indirect
+	github.com/cosmos/go-bip39 v1.0.0
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/decred/base58 v1.0.3
 	github.com/decred/dcrd/crypto/blake256 v1.0.0 // indirect
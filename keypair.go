@@ -3,11 +3,27 @@ package subkey
 // KeyPair can sign, verify using a seed and public key
 type KeyPair interface {
 	Signer
-	Verifier
 
 	// Seed returns the seed of the pair
 	Seed() []byte
 
+	// SS58AddressWithAccountIDChecksum returns the Base58 string.
+	// uses AccountID checksum type
+	// AccountIDChecksum uses the accountID as the blake2b hash pre-image
+	// More here: https://github.com/paritytech/substrate/wiki/External-Address-Format-(SS58)#checksum-types
+	SS58AddressWithAccountIDChecksum(network uint8) (string, error)
+}
+
+// Signer signs and verifies messages on behalf of a single account.
+// Implementations are not required to hold the secret in memory,
+// so HSMs, remote signing services or hardware wallets can satisfy it as well.
+// Every KeyPair is a Signer.
+type Signer interface {
+	Verifier
+
+	// Sign signs the message and returns the signature.
+	Sign(msg []byte) ([]byte, error)
+
 	// Public returns the pub key in bytes.
 	Public() []byte
 
@@ -19,17 +35,6 @@ type KeyPair interface {
 	// SS58Checksum uses the concat(network, accountID) as blake2b hash pre-image
 	// More here: https://github.com/paritytech/substrate/wiki/External-Address-Format-(SS58)#checksum-types
 	SS58Address(network uint8) (string, error)
-
-	// SS58AddressWithAccountIDChecksum returns the Base58 string.
-	// uses AccountID checksum type
-	// AccountIDChecksum uses the accountID as the blake2b hash pre-image
-	// More here: https://github.com/paritytech/substrate/wiki/External-Address-Format-(SS58)#checksum-types
-	SS58AddressWithAccountIDChecksum(network uint8) (string, error)
-}
-
-// Signer signs the message and returns the signature.
-type Signer interface {
-	Sign(msg []byte) ([]byte, error)
 }
 
 // Verifier verifies the signature.
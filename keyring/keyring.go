@@ -0,0 +1,104 @@
+package keyring
+
+import (
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/vedhavyas/go-subkey"
+)
+
+// Store holds multiple signers indexed by their SS58 address and public key.
+// Store is safe for concurrent use.
+type Store struct {
+	network uint8
+
+	mu        sync.RWMutex
+	byAddress map[string]subkey.Signer
+	byPublic  map[string]string
+}
+
+// NewStore returns an empty store that indexes signers by their SS58 address on the network.
+func NewStore(network uint8) *Store {
+	return &Store{
+		network:   network,
+		byAddress: make(map[string]subkey.Signer),
+		byPublic:  make(map[string]string),
+	}
+}
+
+// Add adds the signer to the store and returns its SS58 address.
+func (s *Store) Add(signer subkey.Signer) (string, error) {
+	addr, err := signer.SS58Address(s.network)
+	if err != nil {
+		return "", err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.byAddress[addr]; ok {
+		return "", errors.New("signer already exists")
+	}
+
+	s.byAddress[addr] = signer
+	s.byPublic[subkey.EncodeHex(signer.Public())] = addr
+	return addr, nil
+}
+
+// GetByAddress returns the signer with the SS58 address.
+func (s *Store) GetByAddress(addr string) (subkey.Signer, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	signer, ok := s.byAddress[addr]
+	return signer, ok
+}
+
+// GetByPublicKey returns the signer with the public key.
+func (s *Store) GetByPublicKey(pub []byte) (subkey.Signer, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	addr, ok := s.byPublic[subkey.EncodeHex(pub)]
+	if !ok {
+		return nil, false
+	}
+
+	return s.byAddress[addr], true
+}
+
+// Remove removes the signer with the SS58 address.
+// Returns false if no such signer exists.
+func (s *Store) Remove(addr string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	signer, ok := s.byAddress[addr]
+	if !ok {
+		return false
+	}
+
+	delete(s.byAddress, addr)
+	delete(s.byPublic, subkey.EncodeHex(signer.Public()))
+	return true
+}
+
+// SignWith signs the message with the signer of the SS58 address.
+func (s *Store) SignWith(addr string, msg []byte) ([]byte, error) {
+	signer, ok := s.GetByAddress(addr)
+	if !ok {
+		return nil, errors.New("signer not found")
+	}
+
+	return signer.Sign(msg)
+}
+
+// Addresses returns the sorted SS58 addresses of all the signers in the store.
+func (s *Store) Addresses() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	addrs := make([]string, 0, len(s.byAddress))
+	for addr := range s.byAddress {
+		addrs = append(addrs, addr)
+	}
+
+	sort.Strings(addrs)
+	return addrs
+}
@@ -0,0 +1,65 @@
+package keyring_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vedhavyas/go-subkey"
+	"github.com/vedhavyas/go-subkey/keyring"
+	"github.com/vedhavyas/go-subkey/sr25519"
+)
+
+func TestStore(t *testing.T) {
+	store := keyring.NewStore(42)
+	kr, err := subkey.DeriveKeyPair(sr25519.Scheme{}, "//Alice")
+	assert.NoError(t, err)
+
+	addr, err := store.Add(kr)
+	assert.NoError(t, err)
+	assert.Equal(t, "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY", addr)
+	_, err = store.Add(kr)
+	assert.Error(t, err)
+
+	got, ok := store.GetByAddress(addr)
+	assert.True(t, ok)
+	assert.Equal(t, kr.Public(), got.Public())
+	got, ok = store.GetByPublicKey(kr.Public())
+	assert.True(t, ok)
+	assert.Equal(t, kr.Public(), got.Public())
+
+	msg := []byte("test message")
+	sig, err := store.SignWith(addr, msg)
+	assert.NoError(t, err)
+	assert.True(t, kr.Verify(msg, sig))
+
+	assert.True(t, store.Remove(addr))
+	assert.False(t, store.Remove(addr))
+	_, ok = store.GetByAddress(addr)
+	assert.False(t, ok)
+	_, ok = store.GetByPublicKey(kr.Public())
+	assert.False(t, ok)
+	_, err = store.SignWith(addr, msg)
+	assert.Error(t, err)
+}
+
+func TestStore_Concurrent(t *testing.T) {
+	store := keyring.NewStore(42)
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			kr, err := subkey.DeriveKeyPair(sr25519.Scheme{}, fmt.Sprintf("//%d", i))
+			assert.NoError(t, err)
+			addr, err := store.Add(kr)
+			assert.NoError(t, err)
+			_, err = store.SignWith(addr, []byte("test message"))
+			assert.NoError(t, err)
+		}(i)
+	}
+
+	wg.Wait()
+	assert.Len(t, store.Addresses(), 20)
+}
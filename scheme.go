@@ -15,7 +15,7 @@ type Scheme interface {
 
 // DeriveKeyPair derives the Keypair from the URI using the provided cryptography scheme.
 func DeriveKeyPair(scheme Scheme, uri string) (kp KeyPair, err error) {
-	phrase, path, pwd, err := splitURI(uri)
+	phrase, djs, pwd, err := ParseURI(uri)
 	if err != nil {
 		return nil, err
 	}
@@ -29,10 +29,5 @@ func DeriveKeyPair(scheme Scheme, uri string) (kp KeyPair, err error) {
 		return nil, err
 	}
 
-	djs, err := deriveJunctions(derivePath(path))
-	if err != nil {
-		return nil, err
-	}
-
 	return scheme.Derive(kp, djs)
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/vedhavyas/go-subkey"
 	"github.com/vedhavyas/go-subkey/ecdsa"
 	"github.com/vedhavyas/go-subkey/ed25519"
+	"github.com/vedhavyas/go-subkey/ethereum"
 	"github.com/vedhavyas/go-subkey/sr25519"
 )
 
@@ -242,4 +243,124 @@ func Test_Generate_Sign_Verify(t *testing.T) {
 		assert.NoError(t, err)
 		verify(kr)
 	})
+	t.Run("ethereum", func(t *testing.T) {
+		kr, err := ethereum.Scheme{}.Generate()
+		assert.NoError(t, err)
+		verify(kr)
+	})
+}
+
+func TestEthereum(t *testing.T) {
+	tests := []struct {
+		index     uint32
+		seed      string
+		publicKey string
+		address   string
+	}{
+		{
+			index:     0,
+			seed:      "0x5fb92d6e98884f76de468fa3f6278f8807c48bebc13595d45af5bdc4da702133",
+			publicKey: "0x02509540919faacf9ab52146c9aa40db68172d83777250b28e4679176e49ccdd9f",
+			address:   "0xf24FF3a9CF04c71Dbc94D0b566f7A27B94566cac",
+		},
+		{
+			index:     1,
+			seed:      "0x8075991ce870b93a8870eca0c0f91913d12f47948ca0fd25b49c6fa7cdbeee8b",
+			publicKey: "0x033bc19e36ff1673910575b6727a974a9abd80c9a875d41ab3e2648dbfb9e4b518",
+			address:   "0x3Cd0A705a2DC65e5b1E1205896BaA2be8A07c6e0",
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(ethereum.BIP44Path(c.index), func(t *testing.T) {
+			kr, err := ethereum.Scheme{}.FromPhraseWithPath(subkey.DevPhrase, "", ethereum.BIP44Path(c.index))
+			assert.NoError(t, err)
+			assert.Equal(t, c.seed, subkey.EncodeHex(kr.Seed()))
+			assert.Equal(t, c.publicKey, subkey.EncodeHex(kr.Public()))
+			addr, err := ethereum.Address(kr)
+			assert.NoError(t, err)
+			assert.Equal(t, c.address, addr)
+
+			sig, err := kr.Sign([]byte("test message"))
+			assert.NoError(t, err)
+			assert.Len(t, sig, 65)
+			assert.True(t, kr.Verify([]byte("test message"), sig))
+		})
+	}
+
+	kr, err := subkey.DeriveKeyPair(ethereum.Scheme{}, subkey.DevPhrase)
+	assert.NoError(t, err)
+	assert.Equal(t, tests[0].seed, subkey.EncodeHex(kr.Seed()))
+
+	_, err = subkey.DeriveKeyPair(ethereum.Scheme{}, "//Alice")
+	assert.Error(t, err)
+
+	_, err = ethereum.Scheme{}.FromPhraseWithPath(subkey.DevPhrase, "", "44'/60'/0'/0/0")
+	assert.Error(t, err)
+}
+
+// remoteSigner hides the key material behind the Signer interface,
+// the way an HSM or remote signing service would.
+type remoteSigner struct {
+	kr subkey.KeyPair
+}
+
+func (rs remoteSigner) Sign(msg []byte) ([]byte, error) { return rs.kr.Sign(msg) }
+
+func (rs remoteSigner) Verify(msg []byte, signature []byte) bool { return rs.kr.Verify(msg, signature) }
+
+func (rs remoteSigner) Public() []byte { return rs.kr.Public() }
+
+func (rs remoteSigner) AccountID() []byte { return rs.kr.AccountID() }
+
+func (rs remoteSigner) SS58Address(network uint8) (string, error) { return rs.kr.SS58Address(network) }
+
+func Test_Signer(t *testing.T) {
+	msg := []byte("test message")
+	sign := func(s subkey.Signer) {
+		sig, err := s.Sign(msg)
+		assert.NoError(t, err)
+		assert.True(t, s.Verify(msg, sig))
+	}
+
+	for _, scheme := range []subkey.Scheme{sr25519.Scheme{}, ed25519.Scheme{}, ecdsa.Scheme{}} {
+		t.Run(scheme.String(), func(t *testing.T) {
+			kr, err := subkey.DeriveKeyPair(scheme, "//Alice")
+			assert.NoError(t, err)
+			sign(kr)
+
+			rs := remoteSigner{kr: kr}
+			sign(rs)
+			addr, err := rs.SS58Address(42)
+			assert.NoError(t, err)
+			want, err := kr.SS58Address(42)
+			assert.NoError(t, err)
+			assert.Equal(t, want, addr)
+		})
+	}
+}
+
+func Test_SigningContext(t *testing.T) {
+	msg := []byte("test message")
+	kr, err := subkey.DeriveKeyPair(sr25519.Scheme{}, "//Alice")
+	assert.NoError(t, err)
+
+	cs := kr.(sr25519.ContextSigner)
+	sig, err := cs.SignContext([]byte("custom"), msg)
+	assert.NoError(t, err)
+	assert.True(t, cs.VerifyContext([]byte("custom"), msg, sig))
+	assert.False(t, cs.VerifyContext([]byte(sr25519.DefaultSigningContext), msg, sig))
+	assert.False(t, kr.Verify(msg, sig))
+
+	ckr, err := sr25519.WithSigningContext(kr, []byte("custom"))
+	assert.NoError(t, err)
+	assert.True(t, ckr.Verify(msg, sig))
+	sig, err = ckr.Sign(msg)
+	assert.NoError(t, err)
+	assert.True(t, cs.VerifyContext([]byte("custom"), msg, sig))
+
+	ekr, err := ed25519.Scheme{}.Generate()
+	assert.NoError(t, err)
+	_, err = sr25519.WithSigningContext(ekr, []byte("custom"))
+	assert.Error(t, err)
 }
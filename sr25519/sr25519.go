@@ -14,16 +14,51 @@ const (
 	secretKeyLength = 64
 
 	signatureLength = 64
+
+	// DefaultSigningContext is the signing context used by substrate.
+	DefaultSigningContext = "substrate"
 )
 
+// ContextSigner signs and verifies the messages under a custom signing context.
+type ContextSigner interface {
+	SignContext(ctx, msg []byte) ([]byte, error)
+	VerifyContext(ctx, msg, signature []byte) bool
+}
+
+// WithSigningContext returns a copy of the pair that signs and verifies under the signing context.
+func WithSigningContext(pair subkey.KeyPair, ctx []byte) (subkey.KeyPair, error) {
+	var kr keyRing
+	switch p := pair.(type) {
+	case keyRing:
+		kr = p
+	case *keyRing:
+		kr = *p
+	default:
+		return nil, errors.New("not an sr25519 key pair")
+	}
+
+	kr.ctx = ctx
+	return kr, nil
+}
+
 type keyRing struct {
 	seed   []byte
 	secret *sr25519.SecretKey
 	pub    *sr25519.PublicKey
+	ctx    []byte
 }
 
 func (kr keyRing) Sign(msg []byte) (signature []byte, err error) {
-	sig, err := kr.secret.Sign(signingContext(msg))
+	return kr.SignContext(kr.signingContext(), msg)
+}
+
+func (kr keyRing) Verify(msg []byte, signature []byte) bool {
+	return kr.VerifyContext(kr.signingContext(), msg, signature)
+}
+
+// SignContext signs the message under the signing context.
+func (kr keyRing) SignContext(ctx, msg []byte) (signature []byte, err error) {
+	sig, err := kr.secret.Sign(sr25519.NewSigningContext(ctx, msg))
 	if err != nil {
 		return signature, err
 	}
@@ -32,14 +67,15 @@ func (kr keyRing) Sign(msg []byte) (signature []byte, err error) {
 	return s[:], nil
 }
 
-func (kr keyRing) Verify(msg []byte, signature []byte) bool {
+// VerifyContext verifies the signature of the message made under the signing context.
+func (kr keyRing) VerifyContext(ctx, msg, signature []byte) bool {
 	var sigs [signatureLength]byte
 	copy(sigs[:], signature)
 	sig := new(sr25519.Signature)
 	if err := sig.Decode(sigs); err != nil {
 		return false
 	}
-	ok, err := kr.pub.Verify(sig, signingContext(msg))
+	ok, err := kr.pub.Verify(sig, sr25519.NewSigningContext(ctx, msg))
 	if err != nil || !ok {
 		return false
 	}
@@ -47,8 +83,12 @@ func (kr keyRing) Verify(msg []byte, signature []byte) bool {
 	return true
 }
 
-func signingContext(msg []byte) *merlin.Transcript {
-	return sr25519.NewSigningContext([]byte("substrate"), msg)
+func (kr keyRing) signingContext() []byte {
+	if kr.ctx == nil {
+		return []byte(DefaultSigningContext)
+	}
+
+	return kr.ctx
 }
 
 // Public returns the public key in bytes
@@ -209,5 +249,5 @@ func (s Scheme) Derive(pair subkey.KeyPair, djs []subkey.DeriveJunction) (subkey
 		return nil, err
 	}
 
-	return &keyRing{seed: seed, secret: secret, pub: pub}, nil
+	return &keyRing{seed: seed, secret: secret, pub: pub, ctx: kr.ctx}, nil
 }